@@ -35,8 +35,12 @@ type App struct {
 }
 
 const (
-	AppFPS             = 30
+	AppFPS             = 60
 	AppFPSMilliseconds = time.Second / AppFPS
+	// SpinnerFPS drives the loading spinner's own animation ticker,
+	// independent of the dirty-driven redraw loop.
+	SpinnerFPS             = 10
+	SpinnerFPSMilliseconds = time.Second / SpinnerFPS
 )
 
 var (
@@ -83,7 +87,7 @@ func initApp() {
 		drawables:       make([]*Drawable, 0, 256),
 		systems:         make([]*System, 0, 128),
 		keepAlive:       make(map[interface{}]bool),
-		dirty:           make(chan bool),
+		dirty:           make(chan bool, 1),
 		spinner:         s,
 	}
 }
@@ -92,39 +96,63 @@ func (a *App) Start() {
 	defer a.Close()
 	go a.processTerminalEvents()
 	go a.processOsSignals()
+	go a.runSpinnerTicker()
+
+	// The loop only wakes up once something actually changed (a.dirty
+	// fired), instead of unconditionally redrawing every
+	// AppFPSMilliseconds - that's what used to burn CPU on an idle TUI.
+	// The ticker still caps how often we're willing to redraw, so a burst
+	// of mutators firing SetDirty back-to-back coalesces into one frame.
+	ticker := time.NewTicker(AppFPSMilliseconds)
+	defer ticker.Stop()
 	for {
 		if a.quit == true {
 			return
 		}
-		// TODO - could be added as an potential performance improvement
-		// it will reduce the render cycles
-		//select {
-		//case <-a.dirty:
-		//}
-		a.screen.Show()
-		for _, system := range a.systems {
-			(*system).Update()
-		}
-		a.screen.Clear()
-		if a.loading {
-			a.spinner.Draw(a.screen)
+		select {
+		case <-a.dirty:
 		}
-		for _, drawable := range a.drawables {
-			(*drawable).Draw(a.screen)
-		}
-		if len(a.runOnAppRoutine) == 0 {
-			time.Sleep(AppFPSMilliseconds)
-			continue
-		}
-		funcsToRun := len(a.runOnAppRoutine) - 1
-		for i := funcsToRun; i >= 0; i-- {
-			a.runOnAppRoutine[i]()
+		<-ticker.C
+		a.render()
+	}
+}
+
+func (a *App) render() {
+	a.routineMutex.Lock()
+	funcsToRun := a.runOnAppRoutine
+	a.runOnAppRoutine = nil
+	a.routineMutex.Unlock()
+	for _, f := range funcsToRun {
+		f()
+	}
+
+	a.screen.Show()
+	for _, system := range a.systems {
+		(*system).Update()
+	}
+	a.screen.Clear()
+	if a.loading {
+		a.spinner.Draw(a.screen)
+	}
+	for _, drawable := range a.drawables {
+		(*drawable).Draw(a.screen)
+	}
+}
+
+// runSpinnerTicker drives the loading spinner's own animation frame-by-frame
+// while a.loading is set, marking the app dirty so Start redraws it -
+// separate from SpinnerTCell's own state so the spinner keeps animating
+// without every other mutator needing to know about it.
+func (a *App) runSpinnerTicker() {
+	ticker := time.NewTicker(SpinnerFPSMilliseconds)
+	defer ticker.Stop()
+	for range ticker.C {
+		if a.quit {
+			return
 		}
-		if len(a.runOnAppRoutine) > funcsToRun {
-			a.runOnAppRoutine = a.runOnAppRoutine[funcsToRun+1:]
-			continue
+		if a.loading {
+			a.SetDirty()
 		}
-		a.runOnAppRoutine = nil
 	}
 }
 
@@ -135,14 +163,36 @@ func (a *App) Close() {
 	close(a.keyEvent)
 }
 
+// Suspend tears the screen down, runs f (typically to shell out to an
+// external program like $EDITOR), and re-initializes the screen once f
+// returns. Views that need to temporarily hand the terminal over to
+// another process should use this instead of touching the screen directly.
+func (a *App) Suspend(f func()) {
+	a.screen.Fini()
+	f()
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := screen.Init(); err != nil {
+		log.Fatalf("%+v", err)
+	}
+	screen.SetStyle(tcell.StyleDefault.Background(appBackground).Foreground(tcell.ColorDefault))
+	screen.EnableMouse()
+	a.screen = screen
+	a.ClearNow()
+}
+
 func (a *App) Loading(flag bool) {
 	a.spinner.text = "Fetching"
 	a.loading = flag
+	a.SetDirty()
 }
 
 func (a *App) LoadingWithText(flag bool, text string) {
 	a.spinner.text = text
 	a.loading = flag
+	a.SetDirty()
 }
 
 func (a *App) SetView(view View) {
@@ -158,6 +208,7 @@ func (a *App) SetView(view View) {
 	a.AddDrawable(view.(Drawable))
 	a.AddSystem(view.(System))
 	view.Init()
+	a.SetDirty()
 }
 
 func (a *App) KeepAlive(component interface{}) {
@@ -175,6 +226,7 @@ func (a *App) AddDrawable(drawable Drawable) {
 	if resizable, ok := drawable.(Resizable); ok {
 		resizable.Resize(a.ScreenX, a.ScreenY)
 	}
+	a.SetDirty()
 }
 
 func (a *App) RemoveDrawable(drawable Drawable) {
@@ -193,6 +245,7 @@ func (a *App) RemoveDrawable(drawable Drawable) {
 		a.drawables = append(a.drawables[:index], a.drawables[index+1:]...)
 	}
 	a.changeMutex.Unlock()
+	a.SetDirty()
 }
 
 func (a *App) AddSystem(system System) {
@@ -219,8 +272,19 @@ func (a *App) RemoveSystem(system System) {
 	a.changeMutex.Unlock()
 }
 
+// SetDirty marks the app for a redraw on its next frame. The Start loop
+// only renders in response to this signal, so any Drawable/System whose
+// internal state changes outside of the built-in mutators below (e.g. a
+// background goroutine updating a view's data) must call
+// app.GetApp().SetDirty() itself, or the change won't appear on screen
+// until something else happens to trigger a redraw. The send is
+// non-blocking: a.dirty is a buffered channel of size 1, so redraws
+// requested in a burst coalesce into a single frame instead of queuing up.
 func (a *App) SetDirty() {
-	a.dirty <- true
+	select {
+	case a.dirty <- true:
+	default:
+	}
 }
 
 func (a *App) ClearNow() {
@@ -230,11 +294,16 @@ func (a *App) ClearNow() {
 }
 
 func (a *App) RunOnAppRoutine(f func()) {
+	a.routineMutex.Lock()
 	a.runOnAppRoutine = append(a.runOnAppRoutine, f)
+	a.routineMutex.Unlock()
+	a.SetDirty()
 }
 
 func (a *App) Quit() {
 	a.quit = true
+	// wake Start up so it notices a.quit instead of blocking on a.dirty forever
+	a.SetDirty()
 }
 
 func (a *App) clear() {
@@ -268,6 +337,7 @@ func (a *App) processTerminalEvents() {
 					ft.Resize(x, y)
 				}
 			}
+			a.SetDirty()
 			break
 		case *tcell.EventKey:
 			if ev.Key() == tcell.KeyCtrlC {
@@ -275,7 +345,7 @@ func (a *App) processTerminalEvents() {
 				return
 			}
 			if len(a.systems) == 0 && ev.Key() == tcell.KeyEscape {
-				a.quit = true
+				a.Quit()
 			}
 			// TODO - should keep only one array with components?
 			for _, s := range a.systems {
@@ -283,6 +353,7 @@ func (a *App) processTerminalEvents() {
 					go ft.HandleKeyEvent(ev)
 				}
 			}
+			a.SetDirty()
 		default:
 			continue
 		}
@@ -294,6 +365,6 @@ func (a *App) processOsSignals() {
 	signal.Notify(signals, syscall.SIGHUP, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGINT)
 	go func() {
 		<-signals
-		a.quit = true
+		a.Quit()
 	}()
 }
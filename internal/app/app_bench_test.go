@@ -0,0 +1,75 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell"
+)
+
+func newBenchApp() *App {
+	screen := tcell.NewSimulationScreen("")
+	if err := screen.Init(); err != nil {
+		panic(err)
+	}
+	return &App{
+		screen:  screen,
+		spinner: NewSimpleSpinner(),
+		dirty:   make(chan bool, 1),
+	}
+}
+
+// idleRedrawsOverFixedPolling replays the pre-rework Start loop: it redraws
+// unconditionally every AppFPSMilliseconds regardless of whether anything
+// changed.
+func idleRedrawsOverFixedPolling(a *App, window time.Duration) int {
+	deadline := time.Now().Add(window)
+	redraws := 0
+	for time.Now().Before(deadline) {
+		a.render()
+		redraws++
+		time.Sleep(AppFPSMilliseconds)
+	}
+	return redraws
+}
+
+// idleRedrawsOverDirtyDrivenLoop replays the current Start loop: it only
+// redraws in response to a.dirty firing. With nothing calling SetDirty,
+// this should produce zero redraws over the same window.
+func idleRedrawsOverDirtyDrivenLoop(a *App, window time.Duration) int {
+	ticker := time.NewTicker(AppFPSMilliseconds)
+	defer ticker.Stop()
+	deadline := time.Now().Add(window)
+	redraws := 0
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return redraws
+		}
+		select {
+		case <-a.dirty:
+			<-ticker.C
+			a.render()
+			redraws++
+		case <-time.After(remaining):
+			return redraws
+		}
+	}
+}
+
+// BenchmarkIdleRedraws demonstrates the CPU saving chunk0-6 set out to
+// deliver: while idle (nothing ever calls SetDirty), the dirty-driven loop
+// should redraw far less than the old fixed AppFPSMilliseconds polling loop
+// did over the same wall-clock window.
+func BenchmarkIdleRedraws(b *testing.B) {
+	const window = 50 * time.Millisecond
+	for i := 0; i < b.N; i++ {
+		fixedRedraws := idleRedrawsOverFixedPolling(newBenchApp(), window)
+		dirtyRedraws := idleRedrawsOverDirtyDrivenLoop(newBenchApp(), window)
+		if dirtyRedraws >= fixedRedraws {
+			b.Fatalf("expected dirty-driven loop to redraw far less while idle: fixed=%d dirty=%d", fixedRedraws, dirtyRedraws)
+		}
+		b.ReportMetric(float64(fixedRedraws), "fixed-redraws/op")
+		b.ReportMetric(float64(dirtyRedraws), "dirty-redraws/op")
+	}
+}
@@ -0,0 +1,35 @@
+package app
+
+import "testing"
+
+// TestRunOnAppRoutineRunsQueuedFuncOnNextRender covers the chunk0-5 fix:
+// work queued via RunOnAppRoutine must run on the render goroutine (inside
+// render()), exactly once, instead of racing with it on whatever goroutine
+// queued it.
+func TestRunOnAppRoutineRunsQueuedFuncOnNextRender(t *testing.T) {
+	a := newBenchApp()
+	ran := 0
+	a.RunOnAppRoutine(func() { ran++ })
+	a.render()
+	if ran != 1 {
+		t.Fatalf("ran = %d, want 1 after one render", ran)
+	}
+	a.render()
+	if ran != 1 {
+		t.Fatalf("ran = %d, want 1 - queued func must not run again on a later render", ran)
+	}
+}
+
+// TestSetDirtyIsNonBlockingWhenAlreadyDirty confirms a second SetDirty call
+// coalesces into the pending signal instead of blocking, since a.dirty is a
+// buffered channel of size 1 guarded by a non-blocking select.
+func TestSetDirtyIsNonBlockingWhenAlreadyDirty(t *testing.T) {
+	a := newBenchApp()
+	a.SetDirty()
+	a.SetDirty() // would block forever on an unbuffered/blocking send
+	select {
+	case <-a.dirty:
+	default:
+		t.Fatal("expected a pending dirty signal after two SetDirty calls")
+	}
+}
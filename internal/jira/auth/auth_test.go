@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeParams(t *testing.T) {
+	params := url.Values{
+		"b": {"2"},
+		"a": {"1"},
+		"c": {"hello world"},
+	}
+	got := normalizeParams(params)
+	want := "a=1&b=2&c=hello%20world"
+	if got != want {
+		t.Fatalf("normalizeParams() = %q, want %q", got, want)
+	}
+}
+
+func TestSignProducesWellFormedAuthorizationHeader(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed generating test rsa key: %v", err)
+	}
+	creds := &Credentials{
+		ConsumerKey: "consumer-key",
+		PrivateKey:  key,
+		AccessToken: "access-token",
+	}
+	header, err := creds.Sign("GET", "https://jira.example.com/rest/api/2/issue/ABC-1", url.Values{"fields": {"summary"}})
+	if err != nil {
+		t.Fatalf("Sign() returned error: %v", err)
+	}
+	if !strings.HasPrefix(header, "OAuth ") {
+		t.Fatalf("Sign() header = %q, want it to start with %q", header, "OAuth ")
+	}
+	for _, required := range []string{"oauth_consumer_key=\"consumer-key\"", "oauth_token=\"access-token\"", "oauth_signature_method=\"RSA-SHA1\"", "oauth_signature="} {
+		if !strings.Contains(header, required) {
+			t.Errorf("Sign() header = %q, want it to contain %q", header, required)
+		}
+	}
+}
+
+func TestSignRequiresPrivateKey(t *testing.T) {
+	creds := &Credentials{ConsumerKey: "consumer-key"}
+	if _, err := creds.Sign("GET", "https://jira.example.com", url.Values{}); err == nil {
+		t.Fatal("Sign() with no private key: want error, got nil")
+	}
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	RequestTokenPath = "/plugins/servlet/oauth/request-token"
+	AuthorizePath    = "/plugins/servlet/oauth/authorize"
+	AccessTokenPath  = "/plugins/servlet/oauth/access-token"
+)
+
+// RequestToken performs step one of the OAuth 1.0a dance: it asks the Jira
+// Application Link for a temporary request token, signed with the consumer
+// key and private key only (no access token yet).
+func RequestToken(baseUrl string, creds *Credentials) (token string, tokenSecret string, err error) {
+	values, err := doSignedPost(baseUrl+RequestTokenPath, creds, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("failed requesting oauth request token: %w", err)
+	}
+	token = values.Get("oauth_token")
+	tokenSecret = values.Get("oauth_token_secret")
+	if token == "" {
+		return "", "", fmt.Errorf("jira did not return an oauth_token for the request-token step")
+	}
+	return token, tokenSecret, nil
+}
+
+// AuthorizeUrl builds the URL the user should open in their browser to grant
+// fjira access; Jira will ask them to log in and then display a verifier
+// code to paste back into the TUI.
+func AuthorizeUrl(baseUrl, requestToken string) string {
+	return baseUrl + AuthorizePath + "?oauth_token=" + url.QueryEscape(requestToken)
+}
+
+// ExchangeAccessToken performs the final step: trading the request token and
+// the verifier code the user copied from their browser for a persisted
+// access token + secret.
+func ExchangeAccessToken(baseUrl string, creds *Credentials, requestToken, verifier string) (accessToken string, tokenSecret string, err error) {
+	tmp := &Credentials{ConsumerKey: creds.ConsumerKey, PrivateKey: creds.PrivateKey, AccessToken: requestToken}
+	params := url.Values{"oauth_verifier": {verifier}}
+	values, err := doSignedPost(baseUrl+AccessTokenPath, tmp, params)
+	if err != nil {
+		return "", "", fmt.Errorf("failed exchanging oauth verifier for an access token: %w", err)
+	}
+	accessToken = values.Get("oauth_token")
+	tokenSecret = values.Get("oauth_token_secret")
+	if accessToken == "" {
+		return "", "", fmt.Errorf("jira did not return an oauth_token for the access-token step")
+	}
+	return accessToken, tokenSecret, nil
+}
+
+func doSignedPost(rawUrl string, creds *Credentials, params url.Values) (url.Values, error) {
+	if params == nil {
+		params = url.Values{}
+	}
+	authorization, err := creds.Sign(http.MethodPost, rawUrl, params)
+	if err != nil {
+		return nil, err
+	}
+	fullUrl := rawUrl
+	if encoded := params.Encode(); encoded != "" {
+		fullUrl = rawUrl + "?" + encoded
+	}
+	req, err := http.NewRequest(http.MethodPost, fullUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", authorization)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("jira oauth endpoint %s returned %s: %s", rawUrl, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return url.ParseQuery(string(body))
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	rsaKeyBits          = 2048
+	privateKeyFileName  = "oauth_private_key.pem"
+	publicKeyFileName   = "oauth_public_key.pem"
+	consumerKeyFileName = "oauth_consumer_key.txt"
+	accessTokenFileName = "oauth_access_token.txt"
+)
+
+var cryptoSHA1 = crypto.SHA1
+
+func rsaSHA1Digest(data []byte) ([]byte, error) {
+	h := sha1.New()
+	if _, err := h.Write(data); err != nil {
+		return nil, fmt.Errorf("failed hashing oauth signature base string: %w", err)
+	}
+	return h.Sum(nil), nil
+}
+
+// GenerateKeyPair creates a fresh RSA key pair for the OAuth 1.0a application
+// link and persists both the private key and the PEM-encoded public key (the
+// latter is what the user pastes into Jira's Application Link configuration)
+// in the fjira config dir.
+func GenerateKeyPair(configDir string) (privateKeyPath string, publicKeyPEM string, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	if err != nil {
+		return "", "", fmt.Errorf("failed generating oauth rsa key pair: %w", err)
+	}
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", fmt.Errorf("failed marshalling oauth public key: %w", err)
+	}
+	publicKeyPEM = string(pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: publicKeyBytes,
+	}))
+
+	privateKeyPath = filepath.Join(configDir, privateKeyFileName)
+	if err := os.WriteFile(privateKeyPath, privateKeyPEM, 0600); err != nil {
+		return "", "", fmt.Errorf("failed persisting oauth private key: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, publicKeyFileName), []byte(publicKeyPEM), 0644); err != nil {
+		return "", "", fmt.Errorf("failed persisting oauth public key: %w", err)
+	}
+	return privateKeyPath, publicKeyPEM, nil
+}
+
+// LoadCredentials reads the consumer key and private key persisted by
+// GenerateKeyPair/SaveConsumerKey, plus the access token pair persisted by
+// SaveAccessToken, if any has been exchanged yet.
+func LoadCredentials(configDir string) (*Credentials, error) {
+	privateKeyBytes, err := os.ReadFile(filepath.Join(configDir, privateKeyFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading oauth private key: %w", err)
+	}
+	block, _ := pem.Decode(privateKeyBytes)
+	if block == nil {
+		return nil, fmt.Errorf("failed decoding oauth private key pem at %s", privateKeyFileName)
+	}
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing oauth private key: %w", err)
+	}
+	consumerKeyBytes, err := os.ReadFile(filepath.Join(configDir, consumerKeyFileName))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading oauth consumer key: %w", err)
+	}
+
+	creds := &Credentials{
+		ConsumerKey: string(consumerKeyBytes),
+		PrivateKey:  privateKey,
+	}
+	if accessToken, tokenSecret, err := loadAccessToken(configDir); err == nil {
+		creds.AccessToken = accessToken
+		creds.TokenSecret = tokenSecret
+	}
+	return creds, nil
+}
+
+// SaveConsumerKey persists the consumer key configured on the Jira
+// Application Link so it doesn't need to be re-entered on every run.
+func SaveConsumerKey(configDir, consumerKey string) error {
+	return os.WriteFile(filepath.Join(configDir, consumerKeyFileName), []byte(consumerKey), 0600)
+}
+
+// SaveAccessToken persists the access token + secret obtained from the
+// `/plugins/servlet/oauth/access-token` exchange.
+func SaveAccessToken(configDir, accessToken, tokenSecret string) error {
+	contents := accessToken + "\n" + tokenSecret
+	return os.WriteFile(filepath.Join(configDir, accessTokenFileName), []byte(contents), 0600)
+}
+
+func loadAccessToken(configDir string) (accessToken string, tokenSecret string, err error) {
+	contents, err := os.ReadFile(filepath.Join(configDir, accessTokenFileName))
+	if err != nil {
+		return "", "", err
+	}
+	lines := splitLines(string(contents))
+	if len(lines) < 2 {
+		return "", "", fmt.Errorf("malformed oauth access token file")
+	}
+	return lines[0], lines[1], nil
+}
+
+func splitLines(s string) []string {
+	var lines []string
+	start := 0
+	for i, c := range s {
+		if c == '\n' {
+			lines = append(lines, s[start:i])
+			start = i + 1
+		}
+	}
+	if start < len(s) {
+		lines = append(lines, s[start:])
+	}
+	return lines
+}
@@ -0,0 +1,87 @@
+package jira
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Api is the subset of httpJiraApi/httpApi's methods that fjira.GetApi()
+// exposes to its callers: issue search/creation/transition, assignment,
+// components, comments, and issue links.
+type Api interface {
+	FindIssues(jql string) ([]JiraIssue, error)
+	GetIssueDetailed(issueKey string) (*JiraIssue, error)
+	CreateIssue(projectKey, issueType, priority, summary, description string, labels map[string]string) (*JiraIssue, error)
+	TransitionIssue(issueKey, transitionName string) error
+	DoAssignee(issueId string, accountId *string) error
+	FindUsers(project string) ([]User, error)
+	GetIssueLinks(issueKey string) ([]IssueLink, error)
+	GetIssueLinkTypes() ([]IssueLinkType, error)
+	CreateIssueLink(inwardKey, outwardKey, linkType string) error
+	DeleteIssueLink(linkId string) error
+	FindComponents(projectKey string) ([]Component, error)
+	SetIssueComponents(issueKey string, componentIds []string) error
+	GetComments(issueKey string) ([]Comment, error)
+	AddComment(issueKey, body string) (Comment, error)
+	UpdateComment(issueKey, commentId, body string) error
+	DeleteComment(issueKey, commentId string) error
+}
+
+// httpApi is the underlying Jira REST client: every DoAssignee/FindUsers/
+// GetIssueLinks/... method in this package hangs off of it (as httpApi or
+// its httpJiraApi alias, depending on the file that happened to add the
+// method).
+type httpApi struct {
+	baseUrl string
+	client  *http.Client
+}
+
+// httpJiraApi is an alias for httpApi, not a distinct type - some methods in
+// this package were added with one receiver name, some with the other, but
+// they all operate on the same client.
+type httpJiraApi = httpApi
+
+// NewHttpApi builds the Jira REST client used by both the interactive TUI
+// and `fjira serve`. client's Transport decides how requests are
+// authenticated - basic auth, a bearer token, or (see jira_oauth.go) an
+// OAuth 1.0a Application Link.
+func NewHttpApi(baseUrl string, client *http.Client) *httpJiraApi {
+	return &httpApi{baseUrl: strings.TrimRight(baseUrl, "/"), client: client}
+}
+
+func (api httpApi) jiraRequest(method, path string, queryParams interface{}, body io.Reader) ([]byte, error) {
+	fullUrl := api.baseUrl + path
+	if queryParams != nil {
+		values, err := query.Values(queryParams)
+		if err != nil {
+			return nil, fmt.Errorf("failed encoding query params for %s: %w", path, err)
+		}
+		if encoded := values.Encode(); encoded != "" {
+			fullUrl += "?" + encoded
+		}
+	}
+	req, err := http.NewRequest(method, fullUrl, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed building %s %s: %w", method, path, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := api.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed calling %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading response body for %s %s: %w", method, path, err)
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%s %s returned %s: %s", method, path, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	return respBody, nil
+}
@@ -0,0 +1,38 @@
+package jira
+
+import (
+	"net/http"
+	"os"
+)
+
+// basicAuthTransport sets HTTP Basic Auth on every outgoing request before
+// delegating to the wrapped http.RoundTripper - the fallback authentication
+// method for Jira instances that haven't set up an OAuth 1.0a Application
+// Link.
+type basicAuthTransport struct {
+	login    string
+	apiToken string
+	wrapped  http.RoundTripper
+}
+
+// BasicAuthTransportFromEnv builds a basic-auth transport from the
+// JIRA_LOGIN/JIRA_API_TOKEN environment variables. ok is false if either is
+// unset, so callers like fjira.GetApi() can fail loudly instead of silently
+// sending unauthenticated requests.
+func BasicAuthTransportFromEnv(wrapped http.RoundTripper) (transport http.RoundTripper, ok bool) {
+	login := os.Getenv("JIRA_LOGIN")
+	apiToken := os.Getenv("JIRA_API_TOKEN")
+	if login == "" || apiToken == "" {
+		return nil, false
+	}
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+	return &basicAuthTransport{login: login, apiToken: apiToken, wrapped: wrapped}, true
+}
+
+func (t *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	clonedReq := req.Clone(req.Context())
+	clonedReq.SetBasicAuth(t.login, t.apiToken)
+	return t.wrapped.RoundTrip(clonedReq)
+}
@@ -0,0 +1,73 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	CommentsPath = "/rest/api/2/issue/%s/comment"
+	CommentPath  = "/rest/api/2/issue/%s/comment/%s"
+)
+
+// Comment is a single comment on an issue.
+type Comment struct {
+	Id      string `json:"id"`
+	Author  User   `json:"author"`
+	Body    string `json:"body"`
+	Created string `json:"created"`
+	Updated string `json:"updated"`
+}
+
+type commentsResponse struct {
+	Comments []Comment `json:"comments"`
+}
+
+// GetComments lists the comments on an issue.
+func (api httpJiraApi) GetComments(issueKey string) ([]Comment, error) {
+	url := fmt.Sprintf(CommentsPath, issueKey)
+	response, err := api.jiraRequest("GET", url, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var parsed commentsResponse
+	if err := json.Unmarshal(response, &parsed); err != nil {
+		return nil, fmt.Errorf("cannot deserialize comments response: %w", err)
+	}
+	return parsed.Comments, nil
+}
+
+type commentRequestBody struct {
+	Body string `json:"body"`
+}
+
+// AddComment posts a new comment on an issue.
+func (api httpJiraApi) AddComment(issueKey, body string) (Comment, error) {
+	url := fmt.Sprintf(CommentsPath, issueKey)
+	jsonBody, _ := json.Marshal(&commentRequestBody{Body: body})
+	response, err := api.jiraRequest("POST", url, nil, strings.NewReader(string(jsonBody)))
+	if err != nil {
+		return Comment{}, err
+	}
+	var comment Comment
+	if err := json.Unmarshal(response, &comment); err != nil {
+		return Comment{}, fmt.Errorf("cannot deserialize comment response: %w", err)
+	}
+	return comment, nil
+}
+
+// UpdateComment edits the body of an existing comment.
+func (api httpJiraApi) UpdateComment(issueKey, commentId, body string) error {
+	url := fmt.Sprintf(CommentPath, issueKey, commentId)
+	jsonBody, _ := json.Marshal(&commentRequestBody{Body: body})
+	_, err := api.jiraRequest("PUT", url, nil, strings.NewReader(string(jsonBody)))
+	return err
+}
+
+// DeleteComment removes a comment from an issue.
+func (api httpJiraApi) DeleteComment(issueKey, commentId string) error {
+	url := fmt.Sprintf(CommentPath, issueKey, commentId)
+	_, err := api.jiraRequest("DELETE", url, nil, nil)
+	return err
+}
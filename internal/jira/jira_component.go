@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	FindComponentsPath     = "/rest/api/2/project/%s/components"
+	SetIssueComponentsPath = "/rest/api/2/issue/%s"
+)
+
+// Component is a Jira project component.
+type Component struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Lead        *User  `json:"lead"`
+}
+
+// FindComponents lists the components declared on a project.
+func (api httpJiraApi) FindComponents(projectKey string) ([]Component, error) {
+	url := fmt.Sprintf(FindComponentsPath, projectKey)
+	response, err := api.jiraRequest("GET", url, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var components []Component
+	if err := json.Unmarshal(response, &components); err != nil {
+		return nil, fmt.Errorf("cannot deserialize components response: %w", err)
+	}
+	return components, nil
+}
+
+type setComponentsBody struct {
+	Fields setComponentsFields `json:"fields"`
+}
+
+type setComponentsFields struct {
+	Components []componentIdRef `json:"components"`
+}
+
+type componentIdRef struct {
+	Id string `json:"id"`
+}
+
+// SetIssueComponents replaces an issue's components with the given ids.
+func (api httpJiraApi) SetIssueComponents(issueKey string, componentIds []string) error {
+	refs := make([]componentIdRef, 0, len(componentIds))
+	for _, id := range componentIds {
+		refs = append(refs, componentIdRef{Id: id})
+	}
+	body := &setComponentsBody{Fields: setComponentsFields{Components: refs}}
+	jsonBody, _ := json.Marshal(body)
+	url := fmt.Sprintf(SetIssueComponentsPath, issueKey)
+	_, err := api.jiraRequest("PUT", url, nil, strings.NewReader(string(jsonBody)))
+	return err
+}
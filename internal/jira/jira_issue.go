@@ -0,0 +1,206 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	SearchPath         = "/rest/api/2/search"
+	CreateIssuePath    = "/rest/api/2/issue"
+	GetIssuePath       = "/rest/api/2/issue/%s"
+	GetTransitionsPath = "/rest/api/2/issue/%s/transitions"
+)
+
+// doneStatusCategory is the status category key Jira assigns to every
+// "done"-like status regardless of the workflow's own status names, so
+// callers can tell a resolved issue from an open one without hardcoding a
+// status name like "Done".
+const doneStatusCategory = "done"
+
+// JiraProject is the project an issue belongs to.
+type JiraProject struct {
+	Id   string `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// StatusCategory groups an issue's status into "new", "indeterminate" or
+// "done", independent of the workflow's own status names.
+type StatusCategory struct {
+	Key string `json:"key"`
+}
+
+// IssueStatus is an issue's current workflow status, e.g. "In Progress".
+type IssueStatus struct {
+	Id             string         `json:"id"`
+	Name           string         `json:"name"`
+	StatusCategory StatusCategory `json:"statusCategory"`
+}
+
+// Done reports whether the status belongs to Jira's "done" category,
+// regardless of what this workflow happens to call it.
+func (s IssueStatus) Done() bool {
+	return s.StatusCategory.Key == doneStatusCategory
+}
+
+// JiraIssueFields is the subset of an issue's `fields` object fjira reads
+// and writes.
+type JiraIssueFields struct {
+	Summary    string       `json:"summary"`
+	Status     IssueStatus  `json:"status"`
+	Project    *JiraProject `json:"project"`
+	Components []Component  `json:"components"`
+}
+
+// JiraIssue is a Jira issue, as returned by the search and get-issue
+// endpoints.
+type JiraIssue struct {
+	Id     string          `json:"id"`
+	Key    string          `json:"key"`
+	Fields JiraIssueFields `json:"fields"`
+}
+
+type searchRequestBody struct {
+	Jql string `json:"jql"`
+}
+
+type searchResponse struct {
+	Issues []JiraIssue `json:"issues"`
+}
+
+// FindIssues runs a JQL search and returns the matching issues.
+func (api httpJiraApi) FindIssues(jql string) ([]JiraIssue, error) {
+	jsonBody, _ := json.Marshal(&searchRequestBody{Jql: jql})
+	response, err := api.jiraRequest("POST", SearchPath, nil, strings.NewReader(string(jsonBody)))
+	if err != nil {
+		return nil, err
+	}
+	var parsed searchResponse
+	if err := json.Unmarshal(response, &parsed); err != nil {
+		return nil, fmt.Errorf("cannot deserialize search response: %w", err)
+	}
+	return parsed.Issues, nil
+}
+
+// GetIssueDetailed fetches a single issue by key.
+func (api httpJiraApi) GetIssueDetailed(issueKey string) (*JiraIssue, error) {
+	url := fmt.Sprintf(GetIssuePath, issueKey)
+	response, err := api.jiraRequest("GET", url, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var issue JiraIssue
+	if err := json.Unmarshal(response, &issue); err != nil {
+		return nil, fmt.Errorf("cannot deserialize issue response: %w", err)
+	}
+	return &issue, nil
+}
+
+type createIssueBody struct {
+	Fields createIssueFields `json:"fields"`
+}
+
+type createIssueFields struct {
+	Project     projectKeyRef `json:"project"`
+	IssueType   issueTypeRef  `json:"issuetype"`
+	Priority    *priorityRef  `json:"priority,omitempty"`
+	Summary     string        `json:"summary"`
+	Description string        `json:"description"`
+	Labels      []string      `json:"labels,omitempty"`
+}
+
+type projectKeyRef struct {
+	Key string `json:"key"`
+}
+
+type issueTypeRef struct {
+	Name string `json:"name"`
+}
+
+type priorityRef struct {
+	Name string `json:"name"`
+}
+
+// CreateIssue files a new issue in projectKey. labels' values (its keys are
+// just the caller's own bookkeeping, e.g. a group-label field name) become
+// the issue's `labels` array.
+func (api httpJiraApi) CreateIssue(projectKey, issueType, priority, summary, description string, labels map[string]string) (*JiraIssue, error) {
+	body := &createIssueBody{
+		Fields: createIssueFields{
+			Project:     projectKeyRef{Key: projectKey},
+			IssueType:   issueTypeRef{Name: issueType},
+			Summary:     summary,
+			Description: description,
+			Labels:      labelValues(labels),
+		},
+	}
+	if priority != "" {
+		body.Fields.Priority = &priorityRef{Name: priority}
+	}
+	jsonBody, _ := json.Marshal(body)
+	response, err := api.jiraRequest("POST", CreateIssuePath, nil, strings.NewReader(string(jsonBody)))
+	if err != nil {
+		return nil, err
+	}
+	var created JiraIssue
+	if err := json.Unmarshal(response, &created); err != nil {
+		return nil, fmt.Errorf("cannot deserialize create issue response: %w", err)
+	}
+	return api.GetIssueDetailed(created.Key)
+}
+
+func labelValues(labels map[string]string) []string {
+	values := make([]string, 0, len(labels))
+	for _, v := range labels {
+		values = append(values, v)
+	}
+	return values
+}
+
+type transitionsResponse struct {
+	Transitions []issueTransition `json:"transitions"`
+}
+
+type issueTransition struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type doTransitionBody struct {
+	Transition transitionIdRef `json:"transition"`
+}
+
+type transitionIdRef struct {
+	Id string `json:"id"`
+}
+
+// TransitionIssue moves an issue through its workflow by transition name
+// (e.g. "Done", "Reopen"). Jira's transitions endpoint only accepts a
+// transition id, so this first looks the name up among the issue's
+// currently available transitions.
+func (api httpJiraApi) TransitionIssue(issueKey, transitionName string) error {
+	url := fmt.Sprintf(GetTransitionsPath, issueKey)
+	response, err := api.jiraRequest("GET", url, nil, nil)
+	if err != nil {
+		return err
+	}
+	var parsed transitionsResponse
+	if err := json.Unmarshal(response, &parsed); err != nil {
+		return fmt.Errorf("cannot deserialize transitions response: %w", err)
+	}
+	var transitionId string
+	for _, t := range parsed.Transitions {
+		if strings.EqualFold(t.Name, transitionName) {
+			transitionId = t.Id
+			break
+		}
+	}
+	if transitionId == "" {
+		return fmt.Errorf("issue %s has no %q transition available", issueKey, transitionName)
+	}
+	jsonBody, _ := json.Marshal(&doTransitionBody{Transition: transitionIdRef{Id: transitionId}})
+	_, err = api.jiraRequest("POST", url, nil, strings.NewReader(string(jsonBody)))
+	return err
+}
@@ -0,0 +1,109 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	GetIssueLinksPath   = "/rest/api/2/issue/%s?fields=issuelinks"
+	CreateIssueLinkPath = "/rest/api/2/issueLink"
+	DeleteIssueLinkPath = "/rest/api/2/issueLink/%s"
+	IssueLinkTypesPath  = "/rest/api/2/issueLinkType"
+)
+
+// IssueLinkType is a Jira link type, e.g. "Blocks", with its inward/outward
+// phrasing ("is blocked by" / "blocks").
+type IssueLinkType struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
+
+// IssueLink is a single link between two issues, as returned by the
+// `issuelinks` field of an issue.
+type IssueLink struct {
+	Id           string        `json:"id"`
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  *LinkedIssue  `json:"inwardIssue,omitempty"`
+	OutwardIssue *LinkedIssue  `json:"outwardIssue,omitempty"`
+}
+
+// LinkedIssue is the minimal issue representation embedded in an IssueLink.
+type LinkedIssue struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Summary string `json:"summary"`
+	} `json:"fields"`
+}
+
+type issueLinksResponse struct {
+	Fields struct {
+		IssueLinks []IssueLink `json:"issuelinks"`
+	} `json:"fields"`
+}
+
+// GetIssueLinks fetches the issue links declared on the given issue.
+func (api httpJiraApi) GetIssueLinks(issueKey string) ([]IssueLink, error) {
+	url := fmt.Sprintf(GetIssueLinksPath, issueKey)
+	response, err := api.jiraRequest("GET", url, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var parsed issueLinksResponse
+	if err := json.Unmarshal(response, &parsed); err != nil {
+		return nil, fmt.Errorf("cannot deserialize issue links response: %w", err)
+	}
+	return parsed.Fields.IssueLinks, nil
+}
+
+// GetIssueLinkTypes lists the link types (Blocks, Relates to, ...) available
+// on this Jira instance.
+func (api httpJiraApi) GetIssueLinkTypes() ([]IssueLinkType, error) {
+	response, err := api.jiraRequest("GET", IssueLinkTypesPath, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	var parsed struct {
+		IssueLinkTypes []IssueLinkType `json:"issueLinkTypes"`
+	}
+	if err := json.Unmarshal(response, &parsed); err != nil {
+		return nil, fmt.Errorf("cannot deserialize issue link types response: %w", err)
+	}
+	return parsed.IssueLinkTypes, nil
+}
+
+type createIssueLinkBody struct {
+	Type         issueLinkTypeRef `json:"type"`
+	InwardIssue  issueKeyRef      `json:"inwardIssue"`
+	OutwardIssue issueKeyRef      `json:"outwardIssue"`
+}
+
+type issueLinkTypeRef struct {
+	Name string `json:"name"`
+}
+
+type issueKeyRef struct {
+	Key string `json:"key"`
+}
+
+// CreateIssueLink links inwardKey to outwardKey using the named link type.
+func (api httpJiraApi) CreateIssueLink(inwardKey, outwardKey, linkType string) error {
+	body := &createIssueLinkBody{
+		Type:         issueLinkTypeRef{Name: linkType},
+		InwardIssue:  issueKeyRef{Key: inwardKey},
+		OutwardIssue: issueKeyRef{Key: outwardKey},
+	}
+	jsonBody, _ := json.Marshal(body)
+	_, err := api.jiraRequest("POST", CreateIssueLinkPath, nil, strings.NewReader(string(jsonBody)))
+	return err
+}
+
+// DeleteIssueLink removes a link by its id.
+func (api httpJiraApi) DeleteIssueLink(linkId string) error {
+	url := fmt.Sprintf(DeleteIssueLinkPath, linkId)
+	_, err := api.jiraRequest("DELETE", url, nil, nil)
+	return err
+}
@@ -0,0 +1,65 @@
+package jira
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/mk-5/fjira/internal/jira/auth"
+)
+
+// oauthTransport signs every outgoing request with OAuth 1.0a (RSA-SHA1)
+// before delegating to the wrapped http.RoundTripper, so httpJiraApi and
+// httpApi don't need to know whether they're authenticated with basic auth
+// or an Application Link.
+type oauthTransport struct {
+	credentials *auth.Credentials
+	wrapped     http.RoundTripper
+}
+
+// NewOAuthTransport wraps an http.RoundTripper so every request it sends is
+// signed with the given OAuth 1.0a credentials. Pass it as the Transport of
+// the *http.Client used by httpJiraApi/httpApi to switch a Jira connection
+// from basic auth to an Application Link.
+func NewOAuthTransport(credentials *auth.Credentials, wrapped http.RoundTripper) http.RoundTripper {
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+	return &oauthTransport{credentials: credentials, wrapped: wrapped}
+}
+
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	params := url.Values{}
+	for k, v := range req.URL.Query() {
+		params[k] = v
+	}
+	authorization, err := t.credentials.Sign(req.Method, baseUrlWithoutQuery(req.URL), params)
+	if err != nil {
+		return nil, err
+	}
+	clonedReq := req.Clone(req.Context())
+	clonedReq.Header.Set("Authorization", authorization)
+	return t.wrapped.RoundTrip(clonedReq)
+}
+
+func baseUrlWithoutQuery(u *url.URL) string {
+	clone := *u
+	clone.RawQuery = ""
+	clone.Fragment = ""
+	return clone.String()
+}
+
+// LoadOAuthTransportIfConfigured looks for OAuth 1.0a credentials persisted
+// by `fjira --oauth-setup` under configDir and, if found, returns a
+// transport that signs requests with them instead of basic auth. ok is
+// false (with a nil error) when no credentials have been set up yet, so
+// callers like fjira.GetApi() can fall back to basic auth.
+func LoadOAuthTransportIfConfigured(configDir string, wrapped http.RoundTripper) (transport http.RoundTripper, ok bool, err error) {
+	credentials, err := auth.LoadCredentials(configDir)
+	if err != nil {
+		return nil, false, nil
+	}
+	if credentials.AccessToken == "" {
+		return nil, false, nil
+	}
+	return NewOAuthTransport(credentials, wrapped), true, nil
+}
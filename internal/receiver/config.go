@@ -0,0 +1,63 @@
+// Package receiver implements a headless webhook server that turns
+// Prometheus Alertmanager notifications into Jira issues, reusing the same
+// internal/jira client the interactive TUI uses.
+package receiver
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the top level `fjira serve` configuration file: one entry per
+// Alertmanager receiver that should be turned into Jira issues.
+type Config struct {
+	Receivers []ReceiverConfig `yaml:"receivers"`
+}
+
+// ReceiverConfig describes how a single Alertmanager receiver's alerts are
+// mapped onto a Jira project.
+type ReceiverConfig struct {
+	Name                string `yaml:"name"`
+	ProjectKey          string `yaml:"project_key"`
+	IssueType           string `yaml:"issue_type"`
+	Priority            string `yaml:"priority"`
+	SummaryTemplate     string `yaml:"summary_template"`
+	DescriptionTemplate string `yaml:"description_template"`
+	GroupLabelField     string `yaml:"group_label_field"`
+	UpdateInComment     bool   `yaml:"update_in_comment"`
+	ResolvedTransition  string `yaml:"resolved_transition"`
+	ReopenTransition    string `yaml:"reopen_transition"`
+}
+
+const defaultGroupLabelField = "Labels"
+
+// LoadConfig reads and validates the `fjira serve` receiver configuration
+// from the given YAML file path.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading receiver config %s: %w", path, err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed parsing receiver config %s: %w", path, err)
+	}
+	for i := range cfg.Receivers {
+		if cfg.Receivers[i].GroupLabelField == "" {
+			cfg.Receivers[i].GroupLabelField = defaultGroupLabelField
+		}
+	}
+	return &cfg, nil
+}
+
+// Find returns the receiver config with the given name, if declared.
+func (c *Config) Find(name string) (ReceiverConfig, bool) {
+	for _, r := range c.Receivers {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return ReceiverConfig{}, false
+}
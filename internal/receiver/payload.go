@@ -0,0 +1,27 @@
+package receiver
+
+// WebhookPayload is the body Alertmanager POSTs for its generic webhook
+// receiver. See https://prometheus.io/docs/alerting/latest/configuration/#webhook_config.
+type WebhookPayload struct {
+	Status            string            `json:"status"`
+	GroupKey          string            `json:"groupKey"`
+	Receiver          string            `json:"receiver"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	Alerts            []Alert           `json:"alerts"`
+}
+
+// Alert is a single firing/resolved alert within a WebhookPayload.
+type Alert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     string            `json:"startsAt"`
+	EndsAt       string            `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+}
+
+const (
+	StatusFiring   = "firing"
+	StatusResolved = "resolved"
+)
@@ -0,0 +1,128 @@
+package receiver
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+// JiraClient is the subset of internal/jira's capabilities the receiver
+// needs. httpJiraApi/httpApi (obtained via fjira.GetApi() in the same way
+// the interactive TUI does) already satisfy it - the receiver deliberately
+// has no dependency on the TUI's app package.
+type JiraClient interface {
+	FindIssues(jql string) ([]jira.JiraIssue, error)
+	CreateIssue(projectKey, issueType, priority, summary, description string, labels map[string]string) (*jira.JiraIssue, error)
+	AddComment(issueKey, body string) (jira.Comment, error)
+	TransitionIssue(issueKey, transitionName string) error
+}
+
+// Receiver turns Alertmanager webhook payloads into Jira issues, per the
+// loaded Config.
+type Receiver struct {
+	api    JiraClient
+	config *Config
+}
+
+func NewReceiver(api JiraClient, config *Config) *Receiver {
+	return &Receiver{api: api, config: config}
+}
+
+// ServeHTTP implements the Alertmanager generic webhook_config contract: the
+// receiver name is expected in the `name` query parameter, matched against
+// Config.Receivers.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	receiverName := req.URL.Query().Get("name")
+	cfg, ok := r.config.Find(receiverName)
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown receiver %q", receiverName), http.StatusNotFound)
+		return
+	}
+	var payload WebhookPayload
+	if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := r.handle(cfg, &payload); err != nil {
+		log.Printf("receiver %q: %s", receiverName, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (r *Receiver) handle(cfg ReceiverConfig, payload *WebhookPayload) error {
+	groupHash := hashGroupKey(payload.GroupKey)
+	issue, err := r.findMatchingIssue(cfg, groupHash)
+	if err != nil {
+		return err
+	}
+
+	if payload.Status == StatusResolved {
+		if issue == nil || isDone(issue) || cfg.ResolvedTransition == "" {
+			return nil
+		}
+		return r.api.TransitionIssue(issue.Key, cfg.ResolvedTransition)
+	}
+
+	summary, err := renderTemplate("summary", cfg.SummaryTemplate, payload)
+	if err != nil {
+		return err
+	}
+	description, err := renderTemplate("description", cfg.DescriptionTemplate, payload)
+	if err != nil {
+		return err
+	}
+
+	if issue != nil {
+		if isDone(issue) {
+			if cfg.ReopenTransition == "" {
+				return nil
+			}
+			return r.api.TransitionIssue(issue.Key, cfg.ReopenTransition)
+		}
+		if cfg.UpdateInComment {
+			_, err := r.api.AddComment(issue.Key, description)
+			return err
+		}
+		return nil
+	}
+
+	labels := map[string]string{cfg.GroupLabelField: groupHash}
+	_, err = r.api.CreateIssue(cfg.ProjectKey, cfg.IssueType, cfg.Priority, summary, description, labels)
+	return err
+}
+
+// isDone reports whether issue's status belongs to Jira's "done" category,
+// regardless of what this workflow happens to call it - unlike matching on
+// a literal status name, this still works for reopen detection no matter
+// how findMatchingIssue's JQL is scoped.
+func isDone(issue *jira.JiraIssue) bool {
+	return issue.Fields.Status.Done()
+}
+
+// findMatchingIssue looks for the most recent issue tagged with this alert
+// group's hash, open or resolved, so handle can tell a still-open issue
+// (update in place) apart from a resolved one (reopen) instead of only ever
+// seeing issues a status filter already excluded the resolved ones from.
+func (r *Receiver) findMatchingIssue(cfg ReceiverConfig, groupHash string) (*jira.JiraIssue, error) {
+	jql := fmt.Sprintf(`project = %q AND %s = %q order by created desc`, cfg.ProjectKey, cfg.GroupLabelField, groupHash)
+	issues, err := r.api.FindIssues(jql)
+	if err != nil {
+		return nil, fmt.Errorf("failed searching for existing issue for group %s: %w", groupHash, err)
+	}
+	if len(issues) == 0 {
+		return nil, nil
+	}
+	return &issues[0], nil
+}
+
+func hashGroupKey(groupKey string) string {
+	sum := sha1.Sum([]byte(groupKey))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,119 @@
+package receiver
+
+import (
+	"testing"
+
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+type fakeJiraClient struct {
+	findIssuesResult []jira.JiraIssue
+	createdIssue     bool
+	commentedKey     string
+	transitionedKey  string
+	transitionedName string
+}
+
+func (f *fakeJiraClient) FindIssues(jql string) ([]jira.JiraIssue, error) {
+	return f.findIssuesResult, nil
+}
+
+func (f *fakeJiraClient) CreateIssue(projectKey, issueType, priority, summary, description string, labels map[string]string) (*jira.JiraIssue, error) {
+	f.createdIssue = true
+	return &jira.JiraIssue{Key: "PROJ-1"}, nil
+}
+
+func (f *fakeJiraClient) AddComment(issueKey, body string) (jira.Comment, error) {
+	f.commentedKey = issueKey
+	return jira.Comment{}, nil
+}
+
+func (f *fakeJiraClient) TransitionIssue(issueKey, transitionName string) error {
+	f.transitionedKey = issueKey
+	f.transitionedName = transitionName
+	return nil
+}
+
+func doneIssue(key string) jira.JiraIssue {
+	return jira.JiraIssue{Key: key, Fields: jira.JiraIssueFields{
+		Status: jira.IssueStatus{StatusCategory: jira.StatusCategory{Key: "done"}},
+	}}
+}
+
+func openIssue(key string) jira.JiraIssue {
+	return jira.JiraIssue{Key: key, Fields: jira.JiraIssueFields{
+		Status: jira.IssueStatus{StatusCategory: jira.StatusCategory{Key: "indeterminate"}},
+	}}
+}
+
+func firingPayload() *WebhookPayload {
+	return &WebhookPayload{Status: StatusFiring, GroupKey: "group-1"}
+}
+
+func TestHandleFiringWithNoExistingIssueCreatesOne(t *testing.T) {
+	client := &fakeJiraClient{}
+	r := NewReceiver(client, &Config{})
+	cfg := ReceiverConfig{ProjectKey: "PROJ", GroupLabelField: "Labels"}
+	if err := r.handle(cfg, firingPayload()); err != nil {
+		t.Fatalf("handle() returned error: %v", err)
+	}
+	if !client.createdIssue {
+		t.Fatal("handle() did not create an issue for a firing alert with no existing match")
+	}
+}
+
+func TestHandleFiringWithOpenIssueAddsComment(t *testing.T) {
+	client := &fakeJiraClient{findIssuesResult: []jira.JiraIssue{openIssue("PROJ-1")}}
+	r := NewReceiver(client, &Config{})
+	cfg := ReceiverConfig{ProjectKey: "PROJ", GroupLabelField: "Labels", UpdateInComment: true}
+	if err := r.handle(cfg, firingPayload()); err != nil {
+		t.Fatalf("handle() returned error: %v", err)
+	}
+	if client.commentedKey != "PROJ-1" {
+		t.Fatalf("handle() commentedKey = %q, want %q", client.commentedKey, "PROJ-1")
+	}
+	if client.transitionedKey != "" {
+		t.Fatalf("handle() unexpectedly transitioned %q for an already-open issue", client.transitionedKey)
+	}
+}
+
+// TestHandleFiringWithResolvedIssueReopensIt is the chunk0-2 regression
+// case: a firing alert whose tagged issue was already resolved must reopen
+// it, not silently do nothing because the search excluded resolved issues.
+func TestHandleFiringWithResolvedIssueReopensIt(t *testing.T) {
+	client := &fakeJiraClient{findIssuesResult: []jira.JiraIssue{doneIssue("PROJ-1")}}
+	r := NewReceiver(client, &Config{})
+	cfg := ReceiverConfig{ProjectKey: "PROJ", GroupLabelField: "Labels", ReopenTransition: "Reopen"}
+	if err := r.handle(cfg, firingPayload()); err != nil {
+		t.Fatalf("handle() returned error: %v", err)
+	}
+	if client.transitionedKey != "PROJ-1" || client.transitionedName != "Reopen" {
+		t.Fatalf("handle() did not reopen the resolved issue: key=%q name=%q", client.transitionedKey, client.transitionedName)
+	}
+}
+
+func TestHandleResolvedTransitionsOpenIssue(t *testing.T) {
+	client := &fakeJiraClient{findIssuesResult: []jira.JiraIssue{openIssue("PROJ-1")}}
+	r := NewReceiver(client, &Config{})
+	cfg := ReceiverConfig{ProjectKey: "PROJ", GroupLabelField: "Labels", ResolvedTransition: "Done"}
+	payload := &WebhookPayload{Status: StatusResolved, GroupKey: "group-1"}
+	if err := r.handle(cfg, payload); err != nil {
+		t.Fatalf("handle() returned error: %v", err)
+	}
+	if client.transitionedKey != "PROJ-1" || client.transitionedName != "Done" {
+		t.Fatalf("handle() did not resolve the open issue: key=%q name=%q", client.transitionedKey, client.transitionedName)
+	}
+}
+
+func TestHandleResolvedNoOpForAlreadyDoneIssue(t *testing.T) {
+	client := &fakeJiraClient{findIssuesResult: []jira.JiraIssue{doneIssue("PROJ-1")}}
+	r := NewReceiver(client, &Config{})
+	cfg := ReceiverConfig{ProjectKey: "PROJ", GroupLabelField: "Labels", ResolvedTransition: "Done"}
+	payload := &WebhookPayload{Status: StatusResolved, GroupKey: "group-1"}
+	if err := r.handle(cfg, payload); err != nil {
+		t.Fatalf("handle() returned error: %v", err)
+	}
+	if client.transitionedKey != "" {
+		t.Fatalf("handle() unexpectedly transitioned already-done issue %q", client.transitionedKey)
+	}
+}
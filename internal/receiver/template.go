@@ -0,0 +1,32 @@
+package receiver
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+)
+
+// templateData is the context exposed to a receiver's summary/description
+// templates.
+type templateData struct {
+	CommonLabels      map[string]string
+	CommonAnnotations map[string]string
+	Alerts            []Alert
+}
+
+func renderTemplate(name, text string, payload *WebhookPayload) (string, error) {
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed parsing %s template: %w", name, err)
+	}
+	var buf bytes.Buffer
+	data := templateData{
+		CommonLabels:      payload.CommonLabels,
+		CommonAnnotations: payload.CommonAnnotations,
+		Alerts:            payload.Alerts,
+	}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed executing %s template: %w", name, err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,59 @@
+package fjira
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+var (
+	apiInstance jira.Api
+	apiErr      error
+	apiOnce     sync.Once
+)
+
+// GetApi lazily builds the Jira API client shared by the interactive TUI
+// and `fjira serve`. If `fjira --oauth-setup` has persisted OAuth 1.0a
+// credentials in the config dir, requests are signed with the Application
+// Link; otherwise it falls back to HTTP basic auth from JIRA_LOGIN/
+// JIRA_API_TOKEN. If neither is configured, it returns an error rather than
+// sending unauthenticated requests.
+func GetApi() (jira.Api, error) {
+	apiOnce.Do(func() {
+		apiInstance, apiErr = buildApi()
+	})
+	return apiInstance, apiErr
+}
+
+func buildApi() (jira.Api, error) {
+	dir, err := oauthConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{}
+	if transport, ok, err := jira.LoadOAuthTransportIfConfigured(dir, client.Transport); err != nil {
+		return nil, err
+	} else if ok {
+		client.Transport = transport
+	} else if transport, ok := jira.BasicAuthTransportFromEnv(client.Transport); ok {
+		client.Transport = transport
+	} else {
+		return nil, errors.New("no jira credentials configured: run `fjira --oauth-setup` or set JIRA_LOGIN and JIRA_API_TOKEN")
+	}
+	return jira.NewHttpApi(os.Getenv("JIRA_BASE_URL"), client), nil
+}
+
+// oauthConfigDir is where fjira_oauth_setup.go's RunOAuthSetup persists the
+// RSA key pair, consumer key, and access token - `fjira --oauth-setup`
+// and GetApi() must agree on this path.
+func oauthConfigDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".fjira"), nil
+}
@@ -0,0 +1,22 @@
+package fjira
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// openBrowser best-effort opens the given URL in the user's default
+// browser. Failures are ignored - the URL is always printed to stdout too,
+// so the user can always copy/paste it manually.
+func openBrowser(url string) {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	_ = cmd.Start()
+}
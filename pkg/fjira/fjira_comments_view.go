@@ -0,0 +1,235 @@
+package fjira
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/gdamore/tcell"
+	"github.com/mk-5/fjira/internal/app"
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+const commentsPageSize = 10
+
+// CommentsView shows a scrollable, paginated list of an issue's comments,
+// and lets the user add, edit their own, or delete a comment.
+type CommentsView struct {
+	issue    *jira.JiraIssue
+	comments []jira.Comment
+	page     int
+	selected int
+}
+
+func NewCommentsView(issue *jira.JiraIssue) *CommentsView {
+	return &CommentsView{issue: issue}
+}
+
+func (v *CommentsView) Init() {
+	v.reload()
+}
+
+func (v *CommentsView) Destroy() {
+}
+
+func (v *CommentsView) Resize(int, int) {
+}
+
+func (v *CommentsView) Update() {
+}
+
+func (v *CommentsView) Draw(screen tcell.Screen) {
+	style := tcell.StyleDefault.Foreground(tcell.ColorWhite)
+	drawText(screen, 2, 1, style.Bold(true), fmt.Sprintf("Comments - %s", v.issue.Key))
+
+	pageComments := v.currentPage()
+	for i, comment := range pageComments {
+		row := 3 + i*2
+		lineStyle := style
+		if i == v.selected {
+			lineStyle = style.Background(tcell.ColorDarkSlateGray)
+		}
+		header := fmt.Sprintf("%s  %s", comment.Author.DisplayName, relativeTime(comment.Created))
+		drawText(screen, 2, row, lineStyle.Bold(true), header)
+		drawText(screen, 2, row+1, lineStyle, comment.Body)
+	}
+	footer := fmt.Sprintf("page %d/%d  [a] add  [e] edit  [d] delete  [esc] back", v.page+1, v.totalPages())
+	drawText(screen, 2, 3+len(pageComments)*2+1, style.Foreground(tcell.ColorGray), footer)
+}
+
+func (v *CommentsView) currentPage() []jira.Comment {
+	start := v.page * commentsPageSize
+	if start >= len(v.comments) {
+		return nil
+	}
+	end := start + commentsPageSize
+	if end > len(v.comments) {
+		end = len(v.comments)
+	}
+	return v.comments[start:end]
+}
+
+func (v *CommentsView) totalPages() int {
+	pages := (len(v.comments) + commentsPageSize - 1) / commentsPageSize
+	if pages == 0 {
+		return 1
+	}
+	return pages
+}
+
+func (v *CommentsView) HandleKeyEvent(ev *tcell.EventKey) {
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		goIntoIssueView(v.issue)
+	case tcell.KeyUp:
+		if v.selected > 0 {
+			v.selected--
+		}
+	case tcell.KeyDown:
+		if v.selected < len(v.currentPage())-1 {
+			v.selected++
+		}
+	case tcell.KeyLeft:
+		if v.page > 0 {
+			v.page--
+			v.selected = 0
+		}
+	case tcell.KeyRight:
+		if v.page < v.totalPages()-1 {
+			v.page++
+			v.selected = 0
+		}
+	case tcell.KeyRune:
+		switch ev.Rune() {
+		case 'a':
+			v.queueAddComment()
+		case 'e':
+			v.queueEditSelectedComment()
+		case 'd':
+			v.deleteSelectedComment()
+		}
+	}
+	app.GetApp().SetDirty()
+}
+
+// queueAddComment and queueEditSelectedComment run the whole edit flow -
+// including the screen teardown/rebuild in editInExternalEditor - via
+// RunOnAppRoutine, so it executes on the single render goroutine instead of
+// racing with render()'s own a.screen reads/writes from the key-handler
+// goroutine HandleKeyEvent runs on.
+func (v *CommentsView) queueAddComment() {
+	app.GetApp().RunOnAppRoutine(func() {
+		body := v.editInExternalEditor("")
+		if body == "" {
+			return
+		}
+		api, err := GetApi()
+		if err != nil {
+			app.Error(err.Error())
+			return
+		}
+		if _, err := api.AddComment(v.issue.Key, body); err != nil {
+			app.Error(err.Error())
+			return
+		}
+		v.reload()
+	})
+}
+
+func (v *CommentsView) queueEditSelectedComment() {
+	comment, ok := v.selectedComment()
+	if !ok {
+		return
+	}
+	app.GetApp().RunOnAppRoutine(func() {
+		body := v.editInExternalEditor(comment.Body)
+		if body == "" {
+			return
+		}
+		api, err := GetApi()
+		if err != nil {
+			app.Error(err.Error())
+			return
+		}
+		if err := api.UpdateComment(v.issue.Key, comment.Id, body); err != nil {
+			app.Error(err.Error())
+			return
+		}
+		v.reload()
+	})
+}
+
+func (v *CommentsView) deleteSelectedComment() {
+	comment, ok := v.selectedComment()
+	if !ok {
+		return
+	}
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	if err := api.DeleteComment(v.issue.Key, comment.Id); err != nil {
+		app.Error(err.Error())
+		return
+	}
+	v.reload()
+}
+
+func (v *CommentsView) selectedComment() (jira.Comment, bool) {
+	page := v.currentPage()
+	if v.selected >= len(page) {
+		return jira.Comment{}, false
+	}
+	return page[v.selected], true
+}
+
+// editInExternalEditor suspends the TUI, opens initial in $EDITOR, and
+// returns the edited text once the editor exits.
+func (v *CommentsView) editInExternalEditor(initial string) string {
+	tmpFile, err := os.CreateTemp("", "fjira-comment-*.md")
+	if err != nil {
+		app.Error(err.Error())
+		return ""
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.WriteString(initial); err != nil {
+		app.Error(err.Error())
+		return ""
+	}
+	tmpFile.Close()
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	app.GetApp().Suspend(func() {
+		cmd := exec.Command(editor, tmpFile.Name())
+		cmd.Stdin = os.Stdin
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		_ = cmd.Run()
+	})
+
+	edited, err := os.ReadFile(tmpFile.Name())
+	if err != nil {
+		app.Error(err.Error())
+		return ""
+	}
+	return string(edited)
+}
+
+func (v *CommentsView) reload() {
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	comments, err := api.GetComments(v.issue.Key)
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	v.comments = comments
+}
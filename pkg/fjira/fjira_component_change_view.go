@@ -0,0 +1,115 @@
+package fjira
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+	"github.com/mk-5/fjira/internal/app"
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+// ComponentChangeView lets the user toggle which of the project's
+// components are assigned to an issue, mirroring AssignChangeView's
+// selection flow.
+type ComponentChangeView struct {
+	issue      *jira.JiraIssue
+	components []jira.Component
+	selected   map[string]bool
+	cursor     int
+}
+
+func NewComponentChangeView(issue *jira.JiraIssue) *ComponentChangeView {
+	return &ComponentChangeView{issue: issue, selected: map[string]bool{}}
+}
+
+func (v *ComponentChangeView) Init() {
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	components, err := api.FindComponents(v.issue.Fields.Project.Key)
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	v.components = components
+	for _, c := range v.issue.Fields.Components {
+		v.selected[c.Id] = true
+	}
+}
+
+func (v *ComponentChangeView) Destroy() {
+}
+
+func (v *ComponentChangeView) Resize(int, int) {
+}
+
+func (v *ComponentChangeView) Update() {
+}
+
+func (v *ComponentChangeView) Draw(screen tcell.Screen) {
+	style := tcell.StyleDefault.Foreground(tcell.ColorWhite)
+	drawText(screen, 2, 1, style.Bold(true), fmt.Sprintf("Components - %s", v.issue.Key))
+	for i, component := range v.components {
+		lineStyle := style
+		if i == v.cursor {
+			lineStyle = style.Background(tcell.ColorDarkSlateGray)
+		}
+		checkbox := "[ ]"
+		if v.selected[component.Id] {
+			checkbox = "[x]"
+		}
+		drawText(screen, 2, 3+i, lineStyle, fmt.Sprintf("%s %s", checkbox, component.Name))
+	}
+	drawText(screen, 2, 4+len(v.components), style.Foreground(tcell.ColorGray), "[space] toggle  [enter] save  [esc] cancel")
+}
+
+func (v *ComponentChangeView) HandleKeyEvent(ev *tcell.EventKey) {
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		goIntoIssueView(v.issue)
+	case tcell.KeyEnter:
+		v.save()
+	case tcell.KeyUp:
+		if v.cursor > 0 {
+			v.cursor--
+		}
+	case tcell.KeyDown:
+		if v.cursor < len(v.components)-1 {
+			v.cursor++
+		}
+	case tcell.KeyRune:
+		if ev.Rune() == ' ' && v.cursor < len(v.components) {
+			id := v.components[v.cursor].Id
+			v.selected[id] = !v.selected[id]
+		}
+	}
+	app.GetApp().SetDirty()
+}
+
+func (v *ComponentChangeView) save() {
+	ids := make([]string, 0, len(v.selected))
+	for id, on := range v.selected {
+		if on {
+			ids = append(ids, id)
+		}
+	}
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	if err := api.SetIssueComponents(v.issue.Key, ids); err != nil {
+		app.Error(err.Error())
+		return
+	}
+	goIntoIssueViewFetchIssue(v.issue.Key)
+}
+
+// goIntoChangeComponents navigates to the component multi-select view for
+// the given issue, mirroring goIntoChangeAssignment.
+func goIntoChangeComponents(issue *jira.JiraIssue) {
+	componentChangeView := NewComponentChangeView(issue)
+	app.GetApp().SetView(componentChangeView)
+}
@@ -0,0 +1,13 @@
+package fjira
+
+import "fmt"
+
+// componentFilterJQL builds the `AND component = "..."` clause
+// NewIssuesSearchView appends to its JQL when a component filter has been
+// picked from the dropdown populated via jira.FindComponents.
+func componentFilterJQL(componentName string) string {
+	if componentName == "" {
+		return ""
+	}
+	return fmt.Sprintf(` AND component = %q`, componentName)
+}
@@ -0,0 +1,17 @@
+package fjira
+
+import "testing"
+
+func TestComponentFilterJQLEmpty(t *testing.T) {
+	if got := componentFilterJQL(""); got != "" {
+		t.Fatalf("componentFilterJQL(\"\") = %q, want %q", got, "")
+	}
+}
+
+func TestComponentFilterJQLWithComponent(t *testing.T) {
+	got := componentFilterJQL("Backend")
+	want := ` AND component = "Backend"`
+	if got != want {
+		t.Fatalf("componentFilterJQL(%q) = %q, want %q", "Backend", got, want)
+	}
+}
@@ -0,0 +1,10 @@
+package fjira
+
+import "github.com/gdamore/tcell"
+
+// drawText renders s starting at (x, y), one rune per cell, using style.
+func drawText(screen tcell.Screen, x, y int, style tcell.Style, s string) {
+	for i, r := range []rune(s) {
+		screen.SetContent(x+i, y, r, nil, style)
+	}
+}
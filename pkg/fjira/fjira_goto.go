@@ -1,8 +1,8 @@
 package fjira
 
 import (
-	"github.com/mk5/fjira/internal/app"
-	"github.com/mk5/fjira/internal/jira"
+	"github.com/mk-5/fjira/internal/app"
+	"github.com/mk-5/fjira/internal/jira"
 	"log"
 )
 
@@ -52,3 +52,8 @@ func goIntoChangeAssignment(issue *jira.JiraIssue) {
 	assignChangeView := NewAssignChangeView(issue)
 	app.GetApp().SetView(assignChangeView)
 }
+
+func goIntoComments(issue *jira.JiraIssue) {
+	commentsView := NewCommentsView(issue)
+	app.GetApp().SetView(commentsView)
+}
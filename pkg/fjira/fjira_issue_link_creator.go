@@ -0,0 +1,150 @@
+package fjira
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"github.com/mk-5/fjira/internal/app"
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+// issueLinkCreator is the small inline flow IssueLinksView drops into when
+// the user presses `n`: pick a link type, then fuzzy-search an issue key to
+// link to.
+type issueLinkCreator struct {
+	issue         *jira.JiraIssue
+	linkTypes     []jira.IssueLinkType
+	typeSelected  int
+	pickingIssue  bool
+	query         string
+	candidates    []jira.JiraIssue
+	issueSelected int
+	onDone        func()
+}
+
+func newIssueLinkCreator(issue *jira.JiraIssue, onDone func()) *issueLinkCreator {
+	creator := &issueLinkCreator{issue: issue, onDone: onDone}
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return creator
+	}
+	linkTypes, err := api.GetIssueLinkTypes()
+	if err != nil {
+		app.Error(err.Error())
+		return creator
+	}
+	creator.linkTypes = linkTypes
+	return creator
+}
+
+func (c *issueLinkCreator) Draw(screen tcell.Screen) {
+	style := tcell.StyleDefault.Foreground(tcell.ColorWhite)
+	if !c.pickingIssue {
+		drawText(screen, 2, 3, style.Bold(true), "Select a link type:")
+		for i, t := range c.linkTypes {
+			lineStyle := style
+			if i == c.typeSelected {
+				lineStyle = style.Background(tcell.ColorDarkSlateGray)
+			}
+			drawText(screen, 2, 5+i, lineStyle, t.Name)
+		}
+		return
+	}
+	drawText(screen, 2, 3, style.Bold(true), fmt.Sprintf("Link to issue (%s): %s", c.currentTypeName(), c.query))
+	for i, issue := range c.candidates {
+		lineStyle := style
+		if i == c.issueSelected {
+			lineStyle = style.Background(tcell.ColorDarkSlateGray)
+		}
+		drawText(screen, 2, 5+i, lineStyle, fmt.Sprintf("%s  %s", issue.Key, issue.Fields.Summary))
+	}
+}
+
+func (c *issueLinkCreator) currentTypeName() string {
+	if c.typeSelected >= len(c.linkTypes) {
+		return ""
+	}
+	return c.linkTypes[c.typeSelected].Name
+}
+
+func (c *issueLinkCreator) HandleKeyEvent(ev *tcell.EventKey) {
+	if !c.pickingIssue {
+		switch ev.Key() {
+		case tcell.KeyEscape:
+			c.onDone()
+		case tcell.KeyEnter:
+			c.pickingIssue = true
+		case tcell.KeyUp:
+			if c.typeSelected > 0 {
+				c.typeSelected--
+			}
+		case tcell.KeyDown:
+			if c.typeSelected < len(c.linkTypes)-1 {
+				c.typeSelected++
+			}
+		}
+		app.GetApp().SetDirty()
+		return
+	}
+
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		c.pickingIssue = false
+	case tcell.KeyEnter:
+		c.createLink()
+	case tcell.KeyUp:
+		if c.issueSelected > 0 {
+			c.issueSelected--
+		}
+	case tcell.KeyDown:
+		if c.issueSelected < len(c.candidates)-1 {
+			c.issueSelected++
+		}
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if len(c.query) > 0 {
+			c.query = c.query[:len(c.query)-1]
+			c.search()
+		}
+	case tcell.KeyRune:
+		c.query += string(ev.Rune())
+		c.search()
+	}
+	app.GetApp().SetDirty()
+}
+
+func (c *issueLinkCreator) search() {
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	jql := fmt.Sprintf(`text ~ "%s*" order by updated desc`, strings.ReplaceAll(c.query, `"`, ``))
+	issues, err := api.FindIssues(jql)
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	c.candidates = issues
+	if c.issueSelected >= len(c.candidates) {
+		c.issueSelected = 0
+	}
+}
+
+func (c *issueLinkCreator) createLink() {
+	if c.issueSelected >= len(c.candidates) {
+		return
+	}
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	outwardKey := c.candidates[c.issueSelected].Key
+	if err := api.CreateIssueLink(c.issue.Key, outwardKey, c.currentTypeName()); err != nil {
+		app.Error(err.Error())
+		return
+	}
+	c.onDone()
+}
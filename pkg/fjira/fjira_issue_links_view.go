@@ -0,0 +1,161 @@
+package fjira
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+	"github.com/mk-5/fjira/internal/app"
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+// IssueLinksView lists the issue links declared on an issue, lets the user
+// delete one with `d`, create one with `n`, and jump to a linked issue with
+// Enter.
+type IssueLinksView struct {
+	issue    *jira.JiraIssue
+	links    []jira.IssueLink
+	selected int
+	screenX  int
+	screenY  int
+	creating *issueLinkCreator
+}
+
+func NewIssueLinksView(issue *jira.JiraIssue) *IssueLinksView {
+	return &IssueLinksView{issue: issue}
+}
+
+func (v *IssueLinksView) Init() {
+	v.reload()
+}
+
+func (v *IssueLinksView) Destroy() {
+}
+
+func (v *IssueLinksView) Resize(screenX, screenY int) {
+	v.screenX = screenX
+	v.screenY = screenY
+}
+
+func (v *IssueLinksView) Update() {
+}
+
+func (v *IssueLinksView) Draw(screen tcell.Screen) {
+	style := tcell.StyleDefault.Foreground(tcell.ColorWhite)
+	title := fmt.Sprintf("Issue links - %s", v.issue.Key)
+	drawText(screen, 2, 1, style.Bold(true), title)
+
+	if v.creating != nil {
+		v.creating.Draw(screen)
+		return
+	}
+
+	for i, link := range v.links {
+		row := 3 + i
+		lineStyle := style
+		if i == v.selected {
+			lineStyle = style.Background(tcell.ColorDarkSlateGray)
+		}
+		drawText(screen, 2, row, lineStyle, formatIssueLink(link))
+	}
+	drawText(screen, 2, v.screenY-2, style.Foreground(tcell.ColorGray), "[enter] open  [n] new link  [d] delete  [esc] back")
+}
+
+func formatIssueLink(link jira.IssueLink) string {
+	if link.OutwardIssue != nil {
+		return fmt.Sprintf("%s %s  (%s)", link.Type.Outward, link.OutwardIssue.Key, link.OutwardIssue.Fields.Summary)
+	}
+	if link.InwardIssue != nil {
+		return fmt.Sprintf("%s %s  (%s)", link.Type.Inward, link.InwardIssue.Key, link.InwardIssue.Fields.Summary)
+	}
+	return link.Type.Name
+}
+
+func (v *IssueLinksView) HandleKeyEvent(ev *tcell.EventKey) {
+	if v.creating != nil {
+		v.creating.HandleKeyEvent(ev)
+		app.GetApp().SetDirty()
+		return
+	}
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		goIntoIssueView(v.issue)
+	case tcell.KeyEnter:
+		v.openSelected()
+	case tcell.KeyUp:
+		if v.selected > 0 {
+			v.selected--
+		}
+	case tcell.KeyDown:
+		if v.selected < len(v.links)-1 {
+			v.selected++
+		}
+	case tcell.KeyRune:
+		switch ev.Rune() {
+		case 'd':
+			v.deleteSelected()
+		case 'n':
+			v.startCreating()
+		}
+	}
+	app.GetApp().SetDirty()
+}
+
+func (v *IssueLinksView) openSelected() {
+	if v.selected >= len(v.links) {
+		return
+	}
+	link := v.links[v.selected]
+	if link.OutwardIssue != nil {
+		goIntoIssueViewFetchIssue(link.OutwardIssue.Key)
+	} else if link.InwardIssue != nil {
+		goIntoIssueViewFetchIssue(link.InwardIssue.Key)
+	}
+}
+
+func (v *IssueLinksView) deleteSelected() {
+	if v.selected >= len(v.links) {
+		return
+	}
+	link := v.links[v.selected]
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	if err := api.DeleteIssueLink(link.Id); err != nil {
+		app.Error(err.Error())
+		return
+	}
+	v.reload()
+}
+
+func (v *IssueLinksView) startCreating() {
+	v.creating = newIssueLinkCreator(v.issue, func() {
+		v.creating = nil
+		v.reload()
+	})
+}
+
+func (v *IssueLinksView) reload() {
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	links, err := api.GetIssueLinks(v.issue.Key)
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	v.links = links
+	if v.selected >= len(v.links) {
+		v.selected = 0
+	}
+}
+
+// goIntoIssueLinks navigates to the issue links browser for the given
+// issue, mirroring goIntoChangeStatus/goIntoChangeAssignment.
+func goIntoIssueLinks(issue *jira.JiraIssue) {
+	issueLinksView := NewIssueLinksView(issue)
+	app.GetApp().SetView(issueLinksView)
+}
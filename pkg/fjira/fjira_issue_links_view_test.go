@@ -0,0 +1,40 @@
+package fjira
+
+import (
+	"testing"
+
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+func TestFormatIssueLinkOutward(t *testing.T) {
+	link := jira.IssueLink{
+		Type:         jira.IssueLinkType{Inward: "is blocked by", Outward: "blocks"},
+		OutwardIssue: &jira.LinkedIssue{Key: "PROJ-2"},
+	}
+	link.OutwardIssue.Fields.Summary = "Fix the thing"
+	got := formatIssueLink(link)
+	want := "blocks PROJ-2  (Fix the thing)"
+	if got != want {
+		t.Fatalf("formatIssueLink() = %q, want %q", got, want)
+	}
+}
+
+func TestFormatIssueLinkInward(t *testing.T) {
+	link := jira.IssueLink{
+		Type:        jira.IssueLinkType{Inward: "is blocked by", Outward: "blocks"},
+		InwardIssue: &jira.LinkedIssue{Key: "PROJ-3"},
+	}
+	link.InwardIssue.Fields.Summary = "Break the thing"
+	got := formatIssueLink(link)
+	want := "is blocked by PROJ-3  (Break the thing)"
+	if got != want {
+		t.Fatalf("formatIssueLink() = %q, want %q", got, want)
+	}
+}
+
+func TestFormatIssueLinkWithoutEitherSide(t *testing.T) {
+	link := jira.IssueLink{Type: jira.IssueLinkType{Name: "Relates"}}
+	if got := formatIssueLink(link); got != "Relates" {
+		t.Fatalf("formatIssueLink() = %q, want %q", got, "Relates")
+	}
+}
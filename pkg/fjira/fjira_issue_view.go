@@ -0,0 +1,63 @@
+package fjira
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+	"github.com/mk-5/fjira/internal/app"
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+// IssueView is the issue details screen reached via goIntoIssueView /
+// goIntoIssueViewFetchIssue. It dispatches the issue's actions menu -
+// changing status/assignee/components, browsing issue links, and opening
+// comments.
+type IssueView struct {
+	issue *jira.JiraIssue
+}
+
+func NewIssueView(issue *jira.JiraIssue) *IssueView {
+	return &IssueView{issue: issue}
+}
+
+func (v *IssueView) Init() {
+}
+
+func (v *IssueView) Destroy() {
+}
+
+func (v *IssueView) Resize(int, int) {
+}
+
+func (v *IssueView) Update() {
+}
+
+func (v *IssueView) Draw(screen tcell.Screen) {
+	style := tcell.StyleDefault.Foreground(tcell.ColorWhite)
+	drawText(screen, 2, 1, style.Bold(true), fmt.Sprintf("%s - %s", v.issue.Key, v.issue.Fields.Summary))
+	drawText(screen, 2, 3, style, fmt.Sprintf("Status: %s", v.issue.Fields.Status.Name))
+	drawText(screen, 2, 2, style.Foreground(tcell.ColorGray),
+		"[s] status  [a] assignee  [m] components  [l] links  [c] comments  [esc] back")
+}
+
+func (v *IssueView) HandleKeyEvent(ev *tcell.EventKey) {
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		goIntoIssuesSearch(v.issue.Fields.Project)
+		return
+	case tcell.KeyRune:
+		switch ev.Rune() {
+		case 's':
+			goIntoChangeStatus(v.issue)
+		case 'a':
+			goIntoChangeAssignment(v.issue)
+		case 'l':
+			goIntoIssueLinks(v.issue)
+		case 'm':
+			goIntoChangeComponents(v.issue)
+		case 'c':
+			goIntoComments(v.issue)
+		}
+	}
+	app.GetApp().SetDirty()
+}
@@ -0,0 +1,161 @@
+package fjira
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell"
+	"github.com/mk-5/fjira/internal/app"
+	"github.com/mk-5/fjira/internal/jira"
+)
+
+// IssuesSearchView lists a project's issues, optionally narrowed down to a
+// single component picked from a dropdown populated via FindComponents.
+type IssuesSearchView struct {
+	project           *jira.JiraProject
+	issues            []jira.JiraIssue
+	selected          int
+	components        []jira.Component
+	componentFilter   string
+	pickingComponent  bool
+	componentSelected int
+}
+
+func NewIssuesSearchView(project *jira.JiraProject) *IssuesSearchView {
+	return &IssuesSearchView{project: project}
+}
+
+func (v *IssuesSearchView) Init() {
+	v.search()
+}
+
+func (v *IssuesSearchView) Destroy() {
+}
+
+func (v *IssuesSearchView) Resize(int, int) {
+}
+
+func (v *IssuesSearchView) Update() {
+}
+
+func (v *IssuesSearchView) Draw(screen tcell.Screen) {
+	style := tcell.StyleDefault.Foreground(tcell.ColorWhite)
+	title := fmt.Sprintf("Issues - %s", v.project.Key)
+	if v.componentFilter != "" {
+		title += fmt.Sprintf(" (component: %s)", v.componentFilter)
+	}
+	drawText(screen, 2, 1, style.Bold(true), title)
+
+	if v.pickingComponent {
+		drawText(screen, 2, 3, style.Bold(true), "Filter by component:")
+		drawText(screen, 2, 4, style, "(any)")
+		for i, component := range v.components {
+			row := 5 + i
+			lineStyle := style
+			if i == v.componentSelected {
+				lineStyle = style.Background(tcell.ColorDarkSlateGray)
+			}
+			drawText(screen, 2, row, lineStyle, component.Name)
+		}
+		return
+	}
+
+	for i, issue := range v.issues {
+		row := 3 + i
+		lineStyle := style
+		if i == v.selected {
+			lineStyle = style.Background(tcell.ColorDarkSlateGray)
+		}
+		drawText(screen, 2, row, lineStyle, fmt.Sprintf("%s  %s", issue.Key, issue.Fields.Summary))
+	}
+	drawText(screen, 2, 4+len(v.issues), style.Foreground(tcell.ColorGray), "[enter] open  [f] filter by component  [esc] back")
+}
+
+func (v *IssuesSearchView) HandleKeyEvent(ev *tcell.EventKey) {
+	if v.pickingComponent {
+		v.handlePickingComponent(ev)
+		app.GetApp().SetDirty()
+		return
+	}
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		goIntoProjectsSearch()
+	case tcell.KeyEnter:
+		v.openSelected()
+	case tcell.KeyUp:
+		if v.selected > 0 {
+			v.selected--
+		}
+	case tcell.KeyDown:
+		if v.selected < len(v.issues)-1 {
+			v.selected++
+		}
+	case tcell.KeyRune:
+		if ev.Rune() == 'f' {
+			v.startPickingComponent()
+		}
+	}
+	app.GetApp().SetDirty()
+}
+
+func (v *IssuesSearchView) handlePickingComponent(ev *tcell.EventKey) {
+	switch ev.Key() {
+	case tcell.KeyEscape:
+		v.pickingComponent = false
+	case tcell.KeyEnter:
+		if v.componentSelected == 0 {
+			v.componentFilter = ""
+		} else {
+			v.componentFilter = v.components[v.componentSelected-1].Name
+		}
+		v.pickingComponent = false
+		v.search()
+	case tcell.KeyUp:
+		if v.componentSelected > 0 {
+			v.componentSelected--
+		}
+	case tcell.KeyDown:
+		if v.componentSelected < len(v.components) {
+			v.componentSelected++
+		}
+	}
+}
+
+func (v *IssuesSearchView) startPickingComponent() {
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	components, err := api.FindComponents(v.project.Key)
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	v.components = components
+	v.pickingComponent = true
+}
+
+func (v *IssuesSearchView) openSelected() {
+	if v.selected >= len(v.issues) {
+		return
+	}
+	goIntoIssueViewFetchIssue(v.issues[v.selected].Key)
+}
+
+func (v *IssuesSearchView) search() {
+	api, err := GetApi()
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	jql := fmt.Sprintf("project = %q", v.project.Key) + componentFilterJQL(v.componentFilter)
+	issues, err := api.FindIssues(jql)
+	if err != nil {
+		app.Error(err.Error())
+		return
+	}
+	v.issues = issues
+	if v.selected >= len(v.issues) {
+		v.selected = 0
+	}
+}
@@ -0,0 +1,63 @@
+package fjira
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mk-5/fjira/internal/jira/auth"
+)
+
+// RunOAuthSetup walks the user through turning their Jira Application Link
+// into something fjira can authenticate with: it generates an RSA key pair,
+// prints the public key to paste into Jira's "Application Links" admin
+// screen, then runs the three-legged OAuth 1.0a dance and persists the
+// resulting access token. It's invoked via `fjira --oauth-setup`.
+func RunOAuthSetup(configDir, baseUrl string) error {
+	fmt.Println("Generating RSA key pair for the Jira OAuth 1.0a application link...")
+	_, publicKeyPEM, err := auth.GenerateKeyPair(configDir)
+	if err != nil {
+		return err
+	}
+	fmt.Println("\nPaste the following public key into Jira: Administration > Applications > Application Links > Incoming Authentication:")
+	fmt.Println(publicKeyPEM)
+
+	consumerKey := prompt("Consumer key (as entered on the Application Link): ")
+	if err := auth.SaveConsumerKey(configDir, consumerKey); err != nil {
+		return err
+	}
+
+	creds, err := auth.LoadCredentials(configDir)
+	if err != nil {
+		return err
+	}
+
+	requestToken, _, err := auth.RequestToken(baseUrl, creds)
+	if err != nil {
+		return err
+	}
+
+	authorizeUrl := auth.AuthorizeUrl(baseUrl, requestToken)
+	fmt.Printf("\nOpen the following URL in your browser and approve access:\n%s\n", authorizeUrl)
+	openBrowser(authorizeUrl)
+
+	verifier := prompt("\nVerifier code shown by Jira after approval: ")
+	accessToken, tokenSecret, err := auth.ExchangeAccessToken(baseUrl, creds, requestToken, verifier)
+	if err != nil {
+		return err
+	}
+	if err := auth.SaveAccessToken(configDir, accessToken, tokenSecret); err != nil {
+		return err
+	}
+
+	fmt.Println("\nOAuth setup complete. fjira will now authenticate against this Jira instance using the application link.")
+	return nil
+}
+
+func prompt(label string) string {
+	fmt.Print(label)
+	reader := bufio.NewReader(os.Stdin)
+	line, _ := reader.ReadString('\n')
+	return strings.TrimSpace(line)
+}
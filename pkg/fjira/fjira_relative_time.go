@@ -0,0 +1,30 @@
+package fjira
+
+import (
+	"fmt"
+	"time"
+)
+
+// jiraTimeLayout matches the timestamp format Jira's REST API uses for
+// fields like comment.created/updated, e.g. "2024-01-02T15:04:05.000+0000".
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// relativeTime renders a Jira timestamp as a short "X ago" string, falling
+// back to the raw value if it can't be parsed.
+func relativeTime(jiraTimestamp string) string {
+	parsed, err := time.Parse(jiraTimeLayout, jiraTimestamp)
+	if err != nil {
+		return jiraTimestamp
+	}
+	d := time.Since(parsed)
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	}
+}
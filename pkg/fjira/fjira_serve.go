@@ -0,0 +1,27 @@
+package fjira
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mk-5/fjira/internal/receiver"
+)
+
+// RunServe starts fjira in headless Alertmanager-webhook-receiver mode: it
+// reuses the same Jira API client the interactive TUI uses, but never
+// touches the app package, since there's nothing to draw.
+func RunServe(configPath, addr string) error {
+	cfg, err := receiver.LoadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	api, err := GetApi()
+	if err != nil {
+		return err
+	}
+	r := receiver.NewReceiver(api, cfg)
+	mux := http.NewServeMux()
+	mux.Handle("/webhook", r)
+	fmt.Printf("fjira serve listening on %s\n", addr)
+	return http.ListenAndServe(addr, mux)
+}